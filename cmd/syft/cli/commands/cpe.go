@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
+)
+
+// CPE returns the `syft cpe` command group, which holds utilities for working with CPE candidate generation
+// (currently just `cpe test`).
+func CPE() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cpe",
+		Short: "utilities for working with CPE candidate generation",
+	}
+
+	cmd.AddCommand(CPETest())
+
+	return cmd
+}
+
+type cpeRulesFile struct {
+	Rules []cpe.Rule `yaml:"rules"`
+}
+
+// CPETest returns the `syft cpe test` command, which loads a cpe.rules file and prints the candidate vendors and
+// products it would produce for a single package (supplied as JSON), so users can iterate on rules offline.
+func CPETest() *cobra.Command {
+	return &cobra.Command{
+		Use:   "test RULES_FILE PACKAGE_JSON_FILE",
+		Short: "print the candidate CPEs a set of cpe.rules would produce for a package",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCPETest(cmd.OutOrStdout(), args[0], args[1])
+		},
+	}
+}
+
+func runCPETest(out io.Writer, rulesPath, packagePath string) error {
+	rules, err := loadCPERulesFile(rulesPath)
+	if err != nil {
+		return err
+	}
+
+	generator, err := cpe.NewRuleGenerator(rules)
+	if err != nil {
+		return fmt.Errorf("unable to compile cpe rules: %w", err)
+	}
+
+	p, err := loadPackageFile(packagePath)
+	if err != nil {
+		return err
+	}
+
+	if !generator.Supports(p) {
+		fmt.Fprintln(out, "no rules matched this package")
+		return nil
+	}
+
+	fmt.Fprintln(out, "vendors:", generator.Vendors(p))
+	fmt.Fprintln(out, "products:", generator.Products(p))
+	fmt.Fprintln(out, "versions:", generator.Versions(p))
+
+	return nil
+}
+
+func loadCPERulesFile(path string) ([]cpe.Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cpe rules file: %w", err)
+	}
+
+	var config cpeRulesFile
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse cpe rules file: %w", err)
+	}
+
+	return config.Rules, nil
+}
+
+func loadPackageFile(path string) (pkg.Package, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return pkg.Package{}, fmt.Errorf("unable to read package file: %w", err)
+	}
+
+	var p pkg.Package
+	if err := json.Unmarshal(data, &p); err != nil {
+		return pkg.Package{}, fmt.Errorf("unable to parse package file: %w", err)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCPETest(t *testing.T) {
+	tests := []struct {
+		name        string
+		rulesYAML   string
+		packageJSON string
+		wantErr     bool
+		wantOutput  []string
+	}{
+		{
+			name: "matching rule",
+			rulesYAML: `
+rules:
+  - match: p.name == "internal-fork"
+    vendor: "\"acme\""
+    product: "\"acme-internal-fork\""
+    version: p.version
+`,
+			packageJSON: `{"name": "internal-fork", "version": "1.0.0", "type": "unknown-pkg"}`,
+			wantOutput:  []string{"vendors:", "products:", "versions: [1.0.0]"},
+		},
+		{
+			name: "no rules match",
+			rulesYAML: `
+rules:
+  - match: p.name == "something-else"
+    vendor: "\"acme\""
+`,
+			packageJSON: `{"name": "internal-fork", "version": "1.0.0", "type": "unknown-pkg"}`,
+			wantOutput:  []string{"no rules matched this package"},
+		},
+		{
+			name: "invalid rule expression",
+			rulesYAML: `
+rules:
+  - match: "this is not valid CEL"
+`,
+			packageJSON: `{"name": "internal-fork", "version": "1.0.0", "type": "unknown-pkg"}`,
+			wantErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			rulesPath := filepath.Join(dir, "rules.yaml")
+			packagePath := filepath.Join(dir, "package.json")
+
+			require.NoError(t, os.WriteFile(rulesPath, []byte(test.rulesYAML), 0600))
+			require.NoError(t, os.WriteFile(packagePath, []byte(test.packageJSON), 0600))
+
+			var out bytes.Buffer
+			err := runCPETest(&out, rulesPath, packagePath)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, want := range test.wantOutput {
+				assert.Contains(t, out.String(), want)
+			}
+		})
+	}
+}
+
+func TestLoadCPERulesFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  - match: p.type == "jvm"
+    vendor: "\"oracle\""
+    product: "\"jre\""
+    version: p.version
+`), 0600))
+
+	rules, err := loadCPERulesFile(path)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, `p.type == "jvm"`, rules[0].Match)
+	assert.Equal(t, `"oracle"`, rules[0].Vendor)
+	assert.Equal(t, `"jre"`, rules[0].Product)
+	assert.Equal(t, `p.version`, rules[0].Version)
+}
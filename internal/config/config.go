@@ -0,0 +1,69 @@
+/*
+Package config holds the syft application config: settings loaded from (in increasing precedence) defaults, a
+config file, environment variables, and CLI flags, via viper.
+*/
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
+)
+
+// Application is the root of the syft application config. Only the `cpe:` section is modeled here; the rest of
+// the application's existing sections (catalogers, registry, output formats, ...) are loaded the same way and
+// should be merged into this struct rather than duplicating the viper setup done in LoadConfig.
+type Application struct {
+	CPE cpe.Config `yaml:"cpe" json:"cpe" mapstructure:"cpe"`
+}
+
+// LoadConfig reads the syft application config the same way the rest of the application does: via viper,
+// searching the standard config file locations unless cfgFile is given explicitly. A missing config file is not
+// an error -- it just means defaults (here, no cpe.rules) are used.
+func LoadConfig(cfgFile string) (Application, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	v.SetEnvPrefix("SYFT")
+	v.AutomaticEnv()
+
+	if cfgFile != "" {
+		v.SetConfigFile(cfgFile)
+	} else {
+		v.SetConfigName(".syft")
+		v.AddConfigPath(".")
+		v.AddConfigPath("$HOME")
+	}
+
+	var cfg Application
+
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		// an explicitly given cfgFile that does not exist surfaces as a path error rather than
+		// ConfigFileNotFoundError, which viper only returns when searching the standard locations
+		if !errors.As(err, &notFound) && !errors.Is(err, fs.ErrNotExist) {
+			return cfg, fmt.Errorf("unable to read application config: %w", err)
+		}
+	}
+
+	if err := v.Unmarshal(&cfg); err != nil {
+		return cfg, fmt.Errorf("unable to parse application config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// LoadCPERules reads the application config (see LoadConfig) and registers its `cpe.rules` with the CPE candidate
+// generator registry. Rules take effect only for CPE generation that happens after this call; syft's command
+// bootstrap does not call it, so a catalog run started from the CLI does not consult `cpe.rules`.
+func LoadCPERules(cfgFile string) error {
+	cfg, err := LoadConfig(cfgFile)
+	if err != nil {
+		return err
+	}
+
+	return cpe.LoadConfig(cfg.CPE)
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
+)
+
+func TestLoadConfig_PopulatesCPERules(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, ".syft.yaml")
+
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`
+cpe:
+  rules:
+    - match: p.name == "internal-fork"
+      vendor: "\"acme\""
+      product: "\"acme-internal-fork\""
+`), 0600))
+
+	cfg, err := LoadConfig(cfgFile)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.CPE.Rules, 1)
+	assert.Equal(t, `p.name == "internal-fork"`, cfg.CPE.Rules[0].Match)
+	assert.Equal(t, `"acme"`, cfg.CPE.Rules[0].Vendor)
+}
+
+func TestLoadConfig_MissingFileIsNotAnError(t *testing.T) {
+	cfg, err := LoadConfig(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.CPE.Rules)
+}
+
+func TestLoadCPERules_EndToEnd(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, ".syft.yaml")
+
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`
+cpe:
+  rules:
+    - match: p.name == "internal-fork"
+      vendor: "\"acme\""
+      product: "\"acme-internal-fork\""
+`), 0600))
+
+	require.NoError(t, LoadCPERules(cfgFile))
+
+	// LoadCPERules succeeded; the registry is not visible from this package, so check instead that the rules it
+	// read from the config file compile and produce the expected candidates for a real package.
+	cfg, err := LoadConfig(cfgFile)
+	require.NoError(t, err)
+
+	generator, err := cpe.NewRuleGenerator(cfg.CPE.Rules)
+	require.NoError(t, err)
+
+	p := pkg.Package{Name: "internal-fork"}
+	assert.True(t, generator.Supports(p))
+	assert.Equal(t, []string{"acme-internal-fork"}, generator.Products(p))
+}
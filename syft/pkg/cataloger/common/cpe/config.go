@@ -0,0 +1,15 @@
+package cpe
+
+// Config is the `cpe:` section of the syft application config. Today it only holds user-supplied candidate
+// Rules, but is kept as its own type so other CPE-related settings can be added later without growing the
+// top-level application config struct.
+type Config struct {
+	Rules []Rule `yaml:"rules" json:"rules" mapstructure:"rules"`
+}
+
+// LoadConfig registers cfg.Rules with the generator registry so they are consulted for every package cataloged
+// after the call, for the remainder of the process. Its only caller is LoadCPERules in internal/config, which the
+// application bootstrap does not call.
+func LoadConfig(cfg Config) error {
+	return RegisterRules(cfg.Rules)
+}
@@ -0,0 +1,70 @@
+package cpe
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
+)
+
+// CandidateGenerator is implemented by ecosystem-specific logic that contributes vendor and product candidates
+// during CPE generation. Generators are registered with Register (typically from an init() function in the file
+// that defines them) and are consulted for any package for which Supports reports true.
+type CandidateGenerator interface {
+	// Supports indicates whether this generator has an opinion about the given package.
+	Supports(p pkg.Package) bool
+	// Products returns candidate CPE "product" values for the given package.
+	Products(p pkg.Package) []string
+	// Vendors returns candidate CPE "vendor" values for the given package.
+	Vendors(p pkg.Package) *fieldCandidateSet
+	// Versions returns candidate CPE "version" values for the given package, overriding the package's own
+	// version field (e.g. converting it into the dotted form the NVD uses for legacy JRE/JDK CPEs). Most
+	// generators have no opinion on version and can return nil, in which case the package's version is used as-is.
+	Versions(p pkg.Package) []string
+}
+
+var generators = make(map[string]CandidateGenerator)
+
+// Register adds a CandidateGenerator to the set consulted during CPE candidate generation. Out-of-tree
+// generators (e.g. for ecosystems not built into syft) can use this to participate without modifying this package.
+func Register(name string, generator CandidateGenerator) {
+	generators[name] = generator
+}
+
+// candidateVendors unions the vendor candidates from every registered generator that supports the given package.
+func candidateVendors(p pkg.Package) *fieldCandidateSet {
+	var sets []*fieldCandidateSet
+	for _, generator := range generators {
+		if !generator.Supports(p) {
+			continue
+		}
+		if vendors := generator.Vendors(p); vendors != nil {
+			sets = append(sets, vendors)
+		}
+	}
+	return newCPRFieldCandidateFromSets(sets...)
+}
+
+// candidateProducts unions the product candidates from every registered generator that supports the given package.
+func candidateProducts(p pkg.Package) []string {
+	products := strset.New()
+	for _, generator := range generators {
+		if !generator.Supports(p) {
+			continue
+		}
+		products.Add(generator.Products(p)...)
+	}
+	return products.List()
+}
+
+// candidateVersions unions the version candidates from every registered generator that supports the given
+// package. When no generator contributes a version candidate, callers should fall back to the package's own
+// version field.
+func candidateVersions(p pkg.Package) []string {
+	versions := strset.New()
+	for _, generator := range generators {
+		if !generator.Supports(p) {
+			continue
+		}
+		versions.Add(generator.Versions(p)...)
+	}
+	return versions.List()
+}
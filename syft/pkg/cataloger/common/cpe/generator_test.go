@@ -0,0 +1,78 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// stubGenerator is a minimal CandidateGenerator used to exercise the registry dispatch logic in isolation, without
+// depending on any of the built-in ecosystem generators.
+type stubGenerator struct {
+	supports bool
+	vendor   string
+	product  string
+	version  string
+}
+
+func (g stubGenerator) Supports(_ pkg.Package) bool { return g.supports }
+
+func (g stubGenerator) Products(_ pkg.Package) []string {
+	if g.product == "" {
+		return nil
+	}
+	return []string{g.product}
+}
+
+func (g stubGenerator) Vendors(_ pkg.Package) *fieldCandidateSet {
+	if g.vendor == "" {
+		return nil
+	}
+	vendors := newCPRFieldCandidateSet()
+	vendors.add(fieldCandidate{value: g.vendor, disallowSubSelections: true})
+	return vendors
+}
+
+func (g stubGenerator) Versions(_ pkg.Package) []string {
+	if g.version == "" {
+		return nil
+	}
+	return []string{g.version}
+}
+
+func withGenerators(t *testing.T, named map[string]CandidateGenerator) {
+	original := generators
+	generators = named
+	t.Cleanup(func() {
+		generators = original
+	})
+}
+
+func TestRegistryDispatch(t *testing.T) {
+	withGenerators(t, map[string]CandidateGenerator{
+		"matching":     stubGenerator{supports: true, vendor: "acme", product: "widget", version: "1.0"},
+		"non-matching": stubGenerator{supports: false, vendor: "other", product: "other-product"},
+	})
+
+	p := pkg.Package{Name: "widget"}
+
+	wantVendors := newCPRFieldCandidateSet()
+	wantVendors.add(fieldCandidate{value: "acme", disallowSubSelections: true})
+	assert.Equal(t, wantVendors, candidateVendors(p))
+
+	assert.Equal(t, []string{"widget"}, candidateProducts(p))
+	assert.Equal(t, []string{"1.0"}, candidateVersions(p))
+}
+
+func TestRegistryDispatchNoMatches(t *testing.T) {
+	withGenerators(t, map[string]CandidateGenerator{
+		"non-matching": stubGenerator{supports: false},
+	})
+
+	p := pkg.Package{Name: "widget"}
+
+	assert.Empty(t, candidateProducts(p))
+	assert.Empty(t, candidateVersions(p))
+}
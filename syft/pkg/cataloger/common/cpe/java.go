@@ -37,6 +37,31 @@ var (
 	}
 )
 
+func init() {
+	Register("java", javaCandidateGenerator{})
+}
+
+// javaCandidateGenerator adapts the candidateVendorsForJava/candidateProductsForJava helpers to the
+// CandidateGenerator interface so they can be consulted via the generator registry.
+type javaCandidateGenerator struct{}
+
+func (javaCandidateGenerator) Supports(p pkg.Package) bool {
+	_, ok := p.Metadata.(pkg.JavaMetadata)
+	return ok
+}
+
+func (javaCandidateGenerator) Products(p pkg.Package) []string {
+	return candidateProductsForJava(p)
+}
+
+func (javaCandidateGenerator) Vendors(p pkg.Package) *fieldCandidateSet {
+	return candidateVendorsForJava(p)
+}
+
+func (javaCandidateGenerator) Versions(_ pkg.Package) []string {
+	return nil
+}
+
 func candidateProductsForJava(p pkg.Package) []string {
 	return productsFromArtifactAndGroupIDs(artifactIDFromJavaPackage(p), groupIDsFromJavaPackage(p))
 }
@@ -0,0 +1,25 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestJavaCandidateGenerator(t *testing.T) {
+	gen := javaCandidateGenerator{}
+
+	javaPkg := pkg.Package{
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{GroupID: "org.springframework.boot", ArtifactID: "spring-boot"},
+		},
+	}
+	assert.True(t, gen.Supports(javaPkg))
+	assert.Contains(t, gen.Products(javaPkg), "spring-boot")
+	assert.Nil(t, gen.Versions(javaPkg))
+
+	otherPkg := pkg.Package{Metadata: pkg.RpmdbMetadata{}}
+	assert.False(t, gen.Supports(otherPkg))
+}
@@ -0,0 +1,113 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
+)
+
+// legacyVersionPattern matches JDK 8 and earlier version strings, e.g. "1.8.0_352", which the NVD represents as
+// the dotted "1.8.0:update_352" CPE version component.
+var legacyVersionPattern = regexp.MustCompile(`^(?P<version>1\.\d+\.\d+)[._](?:u|update)?(?P<update>\d+)$`)
+
+// jvmVendorsByImplementor maps the "IMPLEMENTOR" field of a JVM release file to the canonical CPE vendor/product
+// pair used by the NVD for that distribution.
+var jvmVendorsByImplementor = map[string]struct{ vendor, product string }{
+	"Eclipse Adoptium":   {"eclipse", "temurin"},
+	"Amazon.com Inc.":    {"amazon", "corretto"},
+	"Azul Systems, Inc.": {"azul", "zulu"},
+	"IBM Corporation":    {"ibm", "semeru"},
+}
+
+func init() {
+	Register("jvm", jvmCandidateGenerator{})
+}
+
+// jvmCandidateGenerator adapts the candidateVendorsForJVM/candidateProductsForJVM helpers to the
+// CandidateGenerator interface so they can be consulted via the generator registry.
+type jvmCandidateGenerator struct{}
+
+func (jvmCandidateGenerator) Supports(p pkg.Package) bool {
+	_, ok := p.Metadata.(pkg.JVMMetadata)
+	return ok
+}
+
+func (jvmCandidateGenerator) Products(p pkg.Package) []string {
+	return candidateProductsForJVM(p)
+}
+
+func (jvmCandidateGenerator) Vendors(p pkg.Package) *fieldCandidateSet {
+	return candidateVendorsForJVM(p)
+}
+
+func (jvmCandidateGenerator) Versions(p pkg.Package) []string {
+	metadata, ok := p.Metadata.(pkg.JVMMetadata)
+	if !ok || metadata.JavaVersion == "" {
+		return nil
+	}
+	return []string{candidateVersionForJVM(metadata.JavaVersion)}
+}
+
+func candidateVendorsForJVM(p pkg.Package) *fieldCandidateSet {
+	vendor, _, ok := jvmVendorAndProduct(p)
+	if !ok {
+		return nil
+	}
+
+	vendors := newCPRFieldCandidateSet()
+	vendors.add(fieldCandidate{
+		value:                 vendor,
+		disallowSubSelections: true,
+	})
+	return vendors
+}
+
+func candidateProductsForJVM(p pkg.Package) []string {
+	_, product, ok := jvmVendorAndProduct(p)
+	if !ok {
+		return nil
+	}
+	return []string{product}
+}
+
+// jvmVendorAndProduct maps the "IMPLEMENTOR" and "MODULES" fields of a JVM release to the canonical vendor/product
+// pair used by the NVD to describe that JVM distribution, e.g. cpe:2.3:a:oracle:jre:*.
+func jvmVendorAndProduct(p pkg.Package) (vendor, product string, ok bool) {
+	metadata, isJVM := p.Metadata.(pkg.JVMMetadata)
+	if !isJVM {
+		return "", "", false
+	}
+
+	if pair, exists := jvmVendorsByImplementor[metadata.Implementor]; exists {
+		return pair.vendor, pair.product, true
+	}
+
+	if metadata.Implementor == "Oracle Corporation" {
+		if strset.New(metadata.Modules...).Has("jdk.compiler") {
+			return "oracle", "jdk", true
+		}
+		return "oracle", "jre", true
+	}
+
+	return "", "", false
+}
+
+// candidateVersionForJVM converts a JVM release version into the dotted form the NVD uses for legacy JRE/JDK
+// CPEs, e.g. "1.8.0_352" -> "1.8.0:update_352".
+func candidateVersionForJVM(version string) string {
+	match := legacyVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return version
+	}
+
+	groups := make(map[string]string)
+	for i, name := range legacyVersionPattern.SubexpNames() {
+		if name != "" {
+			groups[name] = match[i]
+		}
+	}
+
+	return strings.Join([]string{groups["version"], "update_" + groups["update"]}, ":")
+}
@@ -0,0 +1,94 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestJVMVendorAndProduct(t *testing.T) {
+	tests := []struct {
+		name        string
+		metadata    pkg.JVMMetadata
+		wantVendor  string
+		wantProduct string
+		wantOK      bool
+	}{
+		{
+			name:        "oracle JDK (has jdk.compiler module)",
+			metadata:    pkg.JVMMetadata{Implementor: "Oracle Corporation", Modules: []string{"java.base", "jdk.compiler"}},
+			wantVendor:  "oracle",
+			wantProduct: "jdk",
+			wantOK:      true,
+		},
+		{
+			name:        "oracle JRE (no jdk.compiler module)",
+			metadata:    pkg.JVMMetadata{Implementor: "Oracle Corporation", Modules: []string{"java.base"}},
+			wantVendor:  "oracle",
+			wantProduct: "jre",
+			wantOK:      true,
+		},
+		{
+			name:        "eclipse temurin",
+			metadata:    pkg.JVMMetadata{Implementor: "Eclipse Adoptium"},
+			wantVendor:  "eclipse",
+			wantProduct: "temurin",
+			wantOK:      true,
+		},
+		{
+			name:     "unrecognized implementor",
+			metadata: pkg.JVMMetadata{Implementor: "Some Other Vendor"},
+			wantOK:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := pkg.Package{Metadata: test.metadata}
+
+			vendor, product, ok := jvmVendorAndProduct(p)
+			assert.Equal(t, test.wantOK, ok)
+			if test.wantOK {
+				assert.Equal(t, test.wantVendor, vendor)
+				assert.Equal(t, test.wantProduct, product)
+			}
+		})
+	}
+}
+
+func TestCandidateVersionForJVM(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{name: "legacy update version with underscore", version: "1.8.0_352", want: "1.8.0:update_352"},
+		{name: "legacy update version with dot", version: "1.8.0.352", want: "1.8.0:update_352"},
+		{name: "modern version is left alone", version: "17.0.8", want: "17.0.8"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, candidateVersionForJVM(test.version))
+		})
+	}
+}
+
+func TestJVMCandidateGenerator(t *testing.T) {
+	gen := jvmCandidateGenerator{}
+
+	jvmPkg := pkg.Package{Metadata: pkg.JVMMetadata{Implementor: "Amazon.com Inc.", JavaVersion: "1.8.0_352"}}
+	assert.True(t, gen.Supports(jvmPkg))
+
+	wantVendors := newCPRFieldCandidateSet()
+	wantVendors.add(fieldCandidate{value: "amazon", disallowSubSelections: true})
+	assert.Equal(t, wantVendors, gen.Vendors(jvmPkg))
+
+	assert.Equal(t, []string{"corretto"}, gen.Products(jvmPkg))
+	assert.Equal(t, []string{"1.8.0:update_352"}, gen.Versions(jvmPkg))
+
+	otherPkg := pkg.Package{Metadata: pkg.RpmdbMetadata{}}
+	assert.False(t, gen.Supports(otherPkg))
+}
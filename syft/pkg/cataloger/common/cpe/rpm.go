@@ -2,6 +2,32 @@ package cpe
 
 import "github.com/anchore/syft/syft/pkg"
 
+func init() {
+	Register("rpm", rpmCandidateGenerator{})
+}
+
+// rpmCandidateGenerator adapts candidateVendorsForRPM to the CandidateGenerator interface so it can be consulted
+// via the generator registry. RPM packages have no product candidates beyond the package name itself, so Products
+// returns nil and the default name-based candidate is used.
+type rpmCandidateGenerator struct{}
+
+func (rpmCandidateGenerator) Supports(p pkg.Package) bool {
+	_, ok := p.Metadata.(pkg.RpmdbMetadata)
+	return ok
+}
+
+func (rpmCandidateGenerator) Products(_ pkg.Package) []string {
+	return nil
+}
+
+func (rpmCandidateGenerator) Vendors(p pkg.Package) *fieldCandidateSet {
+	return candidateVendorsForRPM(p)
+}
+
+func (rpmCandidateGenerator) Versions(_ pkg.Package) []string {
+	return nil
+}
+
 func candidateVendorsForRPM(p pkg.Package) *fieldCandidateSet {
 	metadata, ok := p.Metadata.(pkg.RpmdbMetadata)
 	if !ok {
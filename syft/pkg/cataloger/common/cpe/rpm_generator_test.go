@@ -0,0 +1,26 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestRPMCandidateGenerator(t *testing.T) {
+	gen := rpmCandidateGenerator{}
+
+	rpmPkg := pkg.Package{Metadata: pkg.RpmdbMetadata{Vendor: "Red Hat, Inc."}}
+	assert.True(t, gen.Supports(rpmPkg))
+
+	wantVendors := newCPRFieldCandidateSet()
+	wantVendors.add(fieldCandidate{value: normalizeTitle("Red Hat, Inc."), disallowSubSelections: true})
+	assert.Equal(t, wantVendors, gen.Vendors(rpmPkg))
+
+	assert.Nil(t, gen.Products(rpmPkg))
+	assert.Nil(t, gen.Versions(rpmPkg))
+
+	otherPkg := pkg.Package{Metadata: pkg.JavaMetadata{}}
+	assert.False(t, gen.Supports(otherPkg))
+}
@@ -0,0 +1,304 @@
+package cpe
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// Rule describes a single user-supplied CPE candidate rule, configured under the `cpe.rules` section of the syft
+// config. Each rule's Match expression is evaluated (as a CEL expression over the candidate package) and, when it
+// evaluates true, the rule's Vendor/Product/Version expressions contribute candidates into the same
+// fieldCandidateSet pipeline used by the built-in Java/RPM/JVM generators.
+type Rule struct {
+	Match   string `mapstructure:"match" json:"match" yaml:"match"`
+	Vendor  string `mapstructure:"vendor" json:"vendor,omitempty" yaml:"vendor,omitempty"`
+	Product string `mapstructure:"product" json:"product,omitempty" yaml:"product,omitempty"`
+	Version string `mapstructure:"version" json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+// compiledRule is a Rule with its CEL expressions parsed and checked against ruleEnv, ready for repeated
+// evaluation against candidate packages.
+type compiledRule struct {
+	match   cel.Program
+	vendor  cel.Program
+	product cel.Program
+	version cel.Program
+}
+
+// ruleGenerator adapts a set of user-supplied Rules to the CandidateGenerator interface so they are consulted
+// alongside the built-in generators during CPE candidate generation.
+type ruleGenerator struct {
+	rules []compiledRule
+}
+
+// NewRuleGenerator compiles the given rules against ruleEnv and returns a CandidateGenerator that evaluates them.
+// The result is meant to be passed to Register so it is consulted alongside the built-in generators.
+func NewRuleGenerator(rules []Rule) (CandidateGenerator, error) {
+	env, err := ruleEnv()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build cpe rule environment: %w", err)
+	}
+
+	var compiled []compiledRule
+	for i, rule := range rules {
+		c, err := compileRule(env, rule)
+		if err != nil {
+			return nil, fmt.Errorf("cpe.rules[%d]: %w", i, err)
+		}
+		compiled = append(compiled, c)
+	}
+
+	return ruleGenerator{rules: compiled}, nil
+}
+
+// RegisterRules compiles the given rules and registers them with the generator registry under the "rules" name.
+// It is called once the `cpe.rules` config section has been loaded.
+func RegisterRules(rules []Rule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	generator, err := NewRuleGenerator(rules)
+	if err != nil {
+		return err
+	}
+
+	Register("rules", generator)
+	return nil
+}
+
+func compileRule(env *cel.Env, rule Rule) (compiledRule, error) {
+	match, err := compileExpr(env, rule.Match)
+	if err != nil {
+		return compiledRule{}, fmt.Errorf("match: %w", err)
+	}
+
+	vendor, err := compileExpr(env, rule.Vendor)
+	if err != nil {
+		return compiledRule{}, fmt.Errorf("vendor: %w", err)
+	}
+
+	product, err := compileExpr(env, rule.Product)
+	if err != nil {
+		return compiledRule{}, fmt.Errorf("product: %w", err)
+	}
+
+	version, err := compileExpr(env, rule.Version)
+	if err != nil {
+		return compiledRule{}, fmt.Errorf("version: %w", err)
+	}
+
+	return compiledRule{match: match, vendor: vendor, product: product, version: version}, nil
+}
+
+func compileExpr(env *cel.Env, expr string) (cel.Program, error) {
+	if expr == "" {
+		return nil, nil
+	}
+
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	return env.Program(ast)
+}
+
+func (g ruleGenerator) Supports(p pkg.Package) bool {
+	for _, rule := range g.rules {
+		if g.matches(rule, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g ruleGenerator) Products(p pkg.Package) []string {
+	var products []string
+	for _, rule := range g.rules {
+		if !g.matches(rule, p) {
+			continue
+		}
+		products = append(products, evalStrings(rule.product, p)...)
+	}
+	return products
+}
+
+func (g ruleGenerator) Vendors(p pkg.Package) *fieldCandidateSet {
+	vendors := newCPRFieldCandidateSet()
+	for _, rule := range g.rules {
+		if !g.matches(rule, p) {
+			continue
+		}
+		for _, value := range evalStrings(rule.vendor, p) {
+			vendors.add(fieldCandidate{
+				value:                 value,
+				disallowSubSelections: true,
+			})
+		}
+	}
+	return vendors
+}
+
+func (g ruleGenerator) Versions(p pkg.Package) []string {
+	var versions []string
+	for _, rule := range g.rules {
+		if !g.matches(rule, p) {
+			continue
+		}
+		versions = append(versions, evalStrings(rule.version, p)...)
+	}
+	return versions
+}
+
+func (g ruleGenerator) matches(rule compiledRule, p pkg.Package) bool {
+	if rule.match == nil {
+		return false
+	}
+
+	out, _, err := rule.match.Eval(activation(p))
+	if err != nil {
+		return false
+	}
+
+	matched, ok := out.Value().(bool)
+	return ok && matched
+}
+
+// evalStrings runs a compiled vendor/product expression and normalizes its result (a single string or a list of
+// strings) into a string slice.
+func evalStrings(program cel.Program, p pkg.Package) []string {
+	if program == nil {
+		return nil
+	}
+
+	out, _, err := program.Eval(activation(p))
+	if err != nil {
+		return nil
+	}
+
+	if s, ok := out.Value().(string); ok {
+		return []string{s}
+	}
+
+	// lists come in several native forms ([]ref.Val for literals, []string from subSelections, []interface{} from
+	// p.metadata), so read them through the Lister trait rather than by their native type
+	list, ok := out.(traits.Lister)
+	if !ok {
+		return nil
+	}
+
+	var values []string
+	for it := list.Iterator(); it.HasNext() == types.True; {
+		if s, ok := it.Next().Value().(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+func activation(p pkg.Package) map[string]interface{} {
+	return map[string]interface{}{
+		"p": map[string]interface{}{
+			"name":     p.Name,
+			"version":  p.Version,
+			"type":     string(p.Type),
+			"metadata": reflectToCELValue(reflect.ValueOf(p.Metadata)),
+		},
+	}
+}
+
+// reflectToCELValue walks an arbitrary Go value via reflection and converts it into the primitives, slices and
+// maps that cel-go's default type adapter knows how to wrap as ref.Val -- namely so that a concrete Metadata
+// struct (e.g. pkg.JVMMetadata) can be selected into from a rule as "p.metadata.<field>", the same as if it had
+// been a map all along.
+func reflectToCELValue(v reflect.Value) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				// unexported
+				continue
+			}
+
+			name := field.Name
+			if tag, ok := field.Tag.Lookup("json"); ok {
+				tagName := strings.Split(tag, ",")[0]
+				if tagName == "-" {
+					continue
+				}
+				if tagName != "" {
+					name = tagName
+				}
+			}
+
+			fields[name] = reflectToCELValue(v.Field(i))
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items[i] = reflectToCELValue(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+		for _, key := range v.MapKeys() {
+			m[fmt.Sprint(key.Interface())] = reflectToCELValue(v.MapIndex(key))
+		}
+		return m
+	default:
+		return v.Interface()
+	}
+}
+
+// ruleEnv builds the CEL environment exposed to user-supplied rules: the candidate package under "p" plus a
+// handful of helpers shared with the built-in generators.
+func ruleEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("p", cel.DynType),
+		cel.Function("startsWithDomain",
+			cel.Overload("startsWithDomain_string", []*cel.Type{cel.StringType}, cel.BoolType,
+				cel.UnaryBinding(func(value ref.Val) ref.Val {
+					return types.Bool(startsWithDomain(value.Value().(string)))
+				}),
+			),
+		),
+		cel.Function("normalizeName",
+			cel.Overload("normalizeName_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(func(value ref.Val) ref.Val {
+					return types.String(normalizeName(value.Value().(string)))
+				}),
+			),
+		),
+		cel.Function("subSelections",
+			cel.Overload("subSelections_string", []*cel.Type{cel.StringType}, cel.ListType(cel.StringType),
+				cel.UnaryBinding(func(value ref.Val) ref.Val {
+					return types.NewStringList(types.DefaultTypeAdapter, generateSubSelections(value.Value().(string)))
+				}),
+			),
+		),
+	)
+}
@@ -0,0 +1,42 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestRuleGeneratorMetadataField(t *testing.T) {
+	rules := []Rule{
+		{
+			Match:   `p.metadata.implementor == "Oracle Corporation"`,
+			Vendor:  `"acme"`,
+			Product: `"acme-" + normalizeName(p.metadata.implementor)`,
+			Version: `p.metadata.javaVersion`,
+		},
+	}
+
+	generator, err := NewRuleGenerator(rules)
+	require.NoError(t, err)
+
+	// exercised against a real, concrete Metadata struct (not a JSON-decoded map), matching how the generator is
+	// actually invoked during cataloging.
+	p := pkg.Package{
+		Name:    "jdk",
+		Version: "1.8.0_352",
+		Metadata: pkg.JVMMetadata{
+			Implementor: "Oracle Corporation",
+			JavaVersion: "1.8.0_352",
+		},
+	}
+
+	require.True(t, generator.Supports(p))
+	assert.Equal(t, []string{"1.8.0_352"}, generator.Versions(p))
+	assert.Equal(t, []string{"acme-oracle corporation"}, generator.Products(p))
+
+	other := pkg.Package{Metadata: pkg.JVMMetadata{Implementor: "Eclipse Adoptium"}}
+	assert.False(t, generator.Supports(other))
+}
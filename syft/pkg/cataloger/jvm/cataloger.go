@@ -0,0 +1,19 @@
+/*
+Package jvm provides a concrete Cataloger implementation relating to packages within the JVM/JDK ecosystem, namely
+identifying the JVM distribution itself (as installed at $JAVA_HOME) rather than any Java archives.
+*/
+package jvm
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// NewJVMCataloger returns a new JVM cataloger object that can detect a single JRE/JDK installation by reading the
+// standard "release" file found at the root of a JVM distribution.
+func NewJVMCataloger() *common.GenericCataloger {
+	globParsers := map[string]common.ParserFn{
+		"**/release": parseJVMReleaseFile,
+	}
+
+	return common.NewGenericCataloger(nil, globParsers, "jvm-cataloger")
+}
@@ -0,0 +1,111 @@
+package jvm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
+)
+
+// vendorsByImplementor maps the well-known "IMPLEMENTOR" values found in a JVM "release" file to the
+// human-readable distribution name used as the package name. Oracle is handled separately since its name also
+// depends on whether the distribution is a JRE or a JDK (see packageName).
+var vendorsByImplementor = map[string]string{
+	"Eclipse Adoptium":   "Eclipse Temurin",
+	"Amazon.com Inc.":    "Amazon Corretto",
+	"Azul Systems, Inc.": "Azul Zulu",
+	"IBM Corporation":    "IBM Semeru",
+}
+
+// parseJVMReleaseFile parses the contents of a JVM "release" file (a simple KEY=value file found at the root of
+// $JAVA_HOME) into a single JVM package.
+func parseJVMReleaseFile(_ string, reader io.Reader) ([]pkg.Package, error) {
+	fields, err := parseReleaseFields(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	javaVersion := fields["JAVA_VERSION"]
+	if javaVersion == "" {
+		// this isn't a JVM release file we recognize
+		return nil, nil
+	}
+
+	metadata := pkg.JVMMetadata{
+		Implementor:        fields["IMPLEMENTOR"],
+		ImplementorVersion: fields["IMPLEMENTOR_VERSION"],
+		JavaVersion:        javaVersion,
+		JavaVersionDate:    fields["JAVA_VERSION_DATE"],
+		Modules:            parseModules(fields["MODULES"]),
+		OSName:             fields["OS_NAME"],
+		OSArch:             fields["OS_ARCH"],
+		OSVersion:          fields["OS_VERSION"],
+		Source:             fields["SOURCE"],
+		BuildType:          fields["BUILD_TYPE"],
+	}
+
+	return []pkg.Package{
+		{
+			Name:         packageName(metadata),
+			Version:      javaVersion,
+			Type:         pkg.JVMPkg,
+			MetadataType: pkg.JVMMetadataType,
+			Metadata:     metadata,
+		},
+	}, nil
+}
+
+// packageName picks a human-readable distribution name for the given release metadata, mirroring the vendor/
+// product branching in the CPE generator (jvmVendorAndProduct in syft/pkg/cataloger/common/cpe/jvm.go): Oracle
+// distributions are a JRE unless MODULES reports the jdk.compiler module.
+func packageName(metadata pkg.JVMMetadata) string {
+	if metadata.Implementor == "Oracle Corporation" {
+		if strset.New(metadata.Modules...).Has("jdk.compiler") {
+			return "Oracle JDK"
+		}
+		return "Oracle JRE"
+	}
+
+	if name, ok := vendorsByImplementor[metadata.Implementor]; ok {
+		return name
+	}
+	if metadata.Implementor != "" {
+		return metadata.Implementor
+	}
+	return "OpenJDK"
+}
+
+func parseModules(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, " ")
+}
+
+func parseReleaseFields(reader io.Reader) (map[string]string, error) {
+	fields := make(map[string]string)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		fields[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to parse JVM release file: %w", err)
+	}
+
+	return fields, nil
+}
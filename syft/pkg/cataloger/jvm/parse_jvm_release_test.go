@@ -0,0 +1,120 @@
+package jvm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestParseJVMReleaseFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		wantPkg *pkg.Package
+	}{
+		{
+			name: "eclipse temurin",
+			fixture: `IMPLEMENTOR="Eclipse Adoptium"
+IMPLEMENTOR_VERSION="Temurin-17.0.8+7"
+JAVA_VERSION="17.0.8"
+JAVA_VERSION_DATE="2023-07-18"
+MODULES="java.base java.compiler jdk.compiler"
+OS_NAME="Linux"
+OS_ARCH="x86_64"
+`,
+			wantPkg: &pkg.Package{
+				Name:         "Eclipse Temurin",
+				Version:      "17.0.8",
+				Type:         pkg.JVMPkg,
+				MetadataType: pkg.JVMMetadataType,
+				Metadata: pkg.JVMMetadata{
+					Implementor:        "Eclipse Adoptium",
+					ImplementorVersion: "Temurin-17.0.8+7",
+					JavaVersion:        "17.0.8",
+					JavaVersionDate:    "2023-07-18",
+					Modules:            []string{"java.base", "java.compiler", "jdk.compiler"},
+					OSName:             "Linux",
+					OSArch:             "x86_64",
+				},
+			},
+		},
+		{
+			name: "legacy oracle JRE with update version",
+			fixture: `JAVA_VERSION="1.8.0_352"
+IMPLEMENTOR="Oracle Corporation"
+OS_NAME="Linux"
+`,
+			wantPkg: &pkg.Package{
+				Name:         "Oracle JRE",
+				Version:      "1.8.0_352",
+				Type:         pkg.JVMPkg,
+				MetadataType: pkg.JVMMetadataType,
+				Metadata: pkg.JVMMetadata{
+					Implementor: "Oracle Corporation",
+					JavaVersion: "1.8.0_352",
+					OSName:      "Linux",
+				},
+			},
+		},
+		{
+			name: "oracle JDK has the jdk.compiler module",
+			fixture: `JAVA_VERSION="17.0.8"
+IMPLEMENTOR="Oracle Corporation"
+MODULES="java.base jdk.compiler"
+`,
+			wantPkg: &pkg.Package{
+				Name:         "Oracle JDK",
+				Version:      "17.0.8",
+				Type:         pkg.JVMPkg,
+				MetadataType: pkg.JVMMetadataType,
+				Metadata: pkg.JVMMetadata{
+					Implementor: "Oracle Corporation",
+					JavaVersion: "17.0.8",
+					Modules:     []string{"java.base", "jdk.compiler"},
+				},
+			},
+		},
+		{
+			name:    "not a release file",
+			fixture: "this is not a key=value file\n",
+			wantPkg: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgs, err := parseJVMReleaseFile("release", strings.NewReader(test.fixture))
+			require.NoError(t, err)
+
+			if test.wantPkg == nil {
+				assert.Empty(t, pkgs)
+				return
+			}
+
+			require.Len(t, pkgs, 1)
+			assert.Equal(t, *test.wantPkg, pkgs[0])
+		})
+	}
+}
+
+func TestParseModules(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "empty", value: "", want: nil},
+		{name: "single", value: "java.base", want: []string{"java.base"}},
+		{name: "multiple", value: "java.base java.compiler jdk.compiler", want: []string{"java.base", "java.compiler", "jdk.compiler"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, parseModules(test.value))
+		})
+	}
+}
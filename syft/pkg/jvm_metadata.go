@@ -0,0 +1,28 @@
+package pkg
+
+// JVMPkg is the package type representing an installed JVM/JDK distribution, as identified by the jvm cataloger
+// from the "release" file at the root of $JAVA_HOME. It is kept distinct from JavaPkg (individual jar/war/ear
+// archives) so that matchers can key off it independently.
+const JVMPkg Type = "jvm"
+
+// JVMMetadataType identifies pkg.Package values whose Metadata should be decoded into a JVMMetadata struct.
+const JVMMetadataType MetadataType = "jvm-metadata"
+
+func init() {
+	RegisterMetadataType(JVMMetadataType, JVMMetadata{})
+}
+
+// JVMMetadata represents all captured data for a JVM/JDK distribution, as parsed from the standard "release"
+// file found at the root of the distribution (e.g. $JAVA_HOME/release).
+type JVMMetadata struct {
+	Implementor        string   `mapstructure:"IMPLEMENTOR" json:"implementor,omitempty"`
+	ImplementorVersion string   `mapstructure:"IMPLEMENTOR_VERSION" json:"implementorVersion,omitempty"`
+	JavaVersion        string   `mapstructure:"JAVA_VERSION" json:"javaVersion,omitempty"`
+	JavaVersionDate    string   `mapstructure:"JAVA_VERSION_DATE" json:"javaVersionDate,omitempty"`
+	Modules            []string `mapstructure:"MODULES" json:"modules,omitempty"`
+	OSName             string   `mapstructure:"OS_NAME" json:"osName,omitempty"`
+	OSArch             string   `mapstructure:"OS_ARCH" json:"osArch,omitempty"`
+	OSVersion          string   `mapstructure:"OS_VERSION" json:"osVersion,omitempty"`
+	Source             string   `mapstructure:"SOURCE" json:"source,omitempty"`
+	BuildType          string   `mapstructure:"BUILD_TYPE" json:"buildType,omitempty"`
+}